@@ -0,0 +1,122 @@
+// Package grpc provides gRPC server interceptors backed by a pooled validator.Validator, so request
+// messages can be validated as part of the request pipeline rather than by hand in every handler.
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	validator "github.com/EVODelavega/pool-validator"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Option - configures the interceptors returned by UnaryServerInterceptor/StreamServerInterceptor
+type Option func(*options)
+
+type options struct {
+	failFast bool
+	logger   func(context.Context, error)
+}
+
+// WithFailFast - when true (the default), a failing request reports only its first field error; when
+// false, every field error collected by v (e.g. every tag a StructValidator rejected) is joined into
+// a single status
+func WithFailFast(failFast bool) Option {
+	return func(o *options) {
+		o.failFast = failFast
+	}
+}
+
+// WithLogger - registers a callback invoked with every validation failure, so failures can be logged
+// uniformly regardless of which handler triggered them
+func WithLogger(logger func(context.Context, error)) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+func newOptions(opts ...Option) *options {
+	o := &options{failFast: true}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// UnaryServerInterceptor - validates incoming unary requests against v before invoking the handler,
+// returning a codes.InvalidArgument status on failure
+func UnaryServerInterceptor(v validator.Validator, opts ...Option) grpc.UnaryServerInterceptor {
+	o := newOptions(opts...)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := validate(ctx, v, req, o); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor - validates every message received on the stream against v, returning a
+// codes.InvalidArgument status on the first invalid message
+func StreamServerInterceptor(v validator.Validator, opts ...Option) grpc.StreamServerInterceptor {
+	o := newOptions(opts...)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingServerStream{ServerStream: ss, v: v, opts: o})
+	}
+}
+
+// validatingServerStream - wraps a grpc.ServerStream so every RecvMsg is validated before it reaches
+// the handler
+type validatingServerStream struct {
+	grpc.ServerStream
+	v    validator.Validator
+	opts *options
+}
+
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validate(s.Context(), s.v, m, s.opts)
+}
+
+// validate - runs req through v, then reports either just the first field error (fail-fast) or every
+// field error joined together, logging and converting the result into a codes.InvalidArgument status
+func validate(ctx context.Context, v validator.Validator, req interface{}, o *options) error {
+	_, errs := v.ValidateMultipleFullErrStack([][]interface{}{{req}})
+	first := errs.First()
+	if first == nil {
+		return nil
+	}
+	fields := flatten(first)
+	var err error
+	if o.failFast || len(fields) == 1 {
+		err = fields[0]
+	} else {
+		messages := make([]string, len(fields))
+		for i, f := range fields {
+			messages[i] = f.Error()
+		}
+		err = fmt.Errorf("%s", strings.Join(messages, "\n"))
+	}
+	if o.logger != nil {
+		o.logger(ctx, err)
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// flatten - expands e's Cause chain, so a single *ValidationError wrapping an aggregate ErrStack (as
+// produced by a tag-driven validator.StructValidator) surfaces as its individual field errors. A plain
+// error Cause yields just [e].
+func flatten(e *validator.ValidationError) []*validator.ValidationError {
+	if nested, ok := e.Cause.(validator.ErrStack); ok {
+		out := make([]*validator.ValidationError, 0, len(nested))
+		for _, n := range nested {
+			out = append(out, flatten(n)...)
+		}
+		return out
+	}
+	return []*validator.ValidationError{e}
+}
@@ -0,0 +1,126 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	validator "github.com/EVODelavega/pool-validator"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type badForm struct {
+	Name string `validate:"required"`
+	Age  int    `validate:"min=18"`
+}
+
+// getStructBackedValidator - a Validator whose callback aggregates every struct-tag failure into a
+// single ErrStack, the way NewSelfValidating's fallback does; this is what lets WithFailFast actually
+// choose between reporting the first field error or all of them.
+func getStructBackedValidator() validator.Validator {
+	sv := validator.NewStructValidator()
+	new := func() interface{} { return sv }
+	invoke := func(v interface{}, args ...interface{}) (interface{}, error) {
+		if errs := sv.Validate(args[0]); len(errs) > 0 {
+			return args[0], errs
+		}
+		return args[0], nil
+	}
+	return validator.New(new, invoke)
+}
+
+func getTestValidator() validator.Validator {
+	new := func() interface{} { return struct{}{} }
+	invoke := func(v interface{}, args ...interface{}) (interface{}, error) {
+		n := args[0].(int)
+		if n < 0 {
+			return n, fmt.Errorf("%d must not be negative", n)
+		}
+		return n, nil
+	}
+	return validator.New(new, invoke)
+}
+
+func TestUnaryServerInterceptorValid(t *testing.T) {
+	v := getTestValidator()
+	interceptor := UnaryServerInterceptor(v)
+	handlerCalled := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerCalled = true
+		return req, nil
+	}
+	if _, err := interceptor(context.Background(), 5, &grpc.UnaryServerInfo{}, handler); err != nil {
+		t.Fatalf("Unexpected error %+v", err)
+	}
+	if !handlerCalled {
+		t.Fatal("Expected the handler to be invoked for a valid request")
+	}
+}
+
+func TestUnaryServerInterceptorInvalid(t *testing.T) {
+	v := getTestValidator()
+	interceptor := UnaryServerInterceptor(v)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Fatal("Handler should not be invoked for an invalid request")
+		return req, nil
+	}
+	_, err := interceptor(context.Background(), -1, &grpc.UnaryServerInfo{}, handler)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expected codes.InvalidArgument, got %+v", err)
+	}
+}
+
+func TestUnaryServerInterceptorWithLogger(t *testing.T) {
+	v := getTestValidator()
+	var logged error
+	interceptor := UnaryServerInterceptor(v, WithLogger(func(ctx context.Context, err error) {
+		logged = err
+	}))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	}
+	if _, err := interceptor(context.Background(), -1, &grpc.UnaryServerInfo{}, handler); err == nil {
+		t.Fatal("Expected an error")
+	}
+	if logged == nil {
+		t.Fatal("Expected the logger callback to be invoked with the validation error")
+	}
+}
+
+func TestUnaryServerInterceptorFailFastVsCollectAll(t *testing.T) {
+	v := getStructBackedValidator()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return req, nil }
+	req := badForm{Name: "", Age: 5}
+
+	fastInterceptor := UnaryServerInterceptor(v, WithFailFast(true))
+	_, fastErr := fastInterceptor(context.Background(), req, &grpc.UnaryServerInfo{}, handler)
+	if status.Code(fastErr) != codes.InvalidArgument {
+		t.Fatalf("Expected codes.InvalidArgument, got %+v", fastErr)
+	}
+
+	collectInterceptor := UnaryServerInterceptor(v, WithFailFast(false))
+	_, collectErr := collectInterceptor(context.Background(), req, &grpc.UnaryServerInfo{}, handler)
+	if status.Code(collectErr) != codes.InvalidArgument {
+		t.Fatalf("Expected codes.InvalidArgument, got %+v", collectErr)
+	}
+
+	fastMsg := status.Convert(fastErr).Message()
+	collectMsg := status.Convert(collectErr).Message()
+	if fastMsg == collectMsg {
+		t.Fatalf("Expected fail-fast and collect-all messages to differ, both were %q", fastMsg)
+	}
+	if strings.Count(fastMsg, "\n") != 0 {
+		t.Fatalf("Expected fail-fast to report a single field error, got %q", fastMsg)
+	}
+	if strings.Count(collectMsg, "\n") == 0 {
+		t.Fatalf("Expected collect-all to report more than one field error, got %q", collectMsg)
+	}
+	for _, field := range []string{"Name", "Age"} {
+		if !strings.Contains(collectMsg, field) {
+			t.Fatalf("Expected collect-all message %q to name the failing field %q", collectMsg, field)
+		}
+	}
+}
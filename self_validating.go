@@ -0,0 +1,138 @@
+package validator
+
+import (
+	"context"
+	"sync"
+)
+
+// Validatable - implemented by types that know how to validate themselves. Arguments implementing
+// this interface carry their own validation state, so they bypass the pooled callback entirely.
+type Validatable interface {
+	Validate(ctx context.Context) error
+}
+
+// selfValidating - a Validator that, for each argument implementing Validatable, calls its Validate
+// method directly instead of going through a user-supplied callback. Arguments that don't implement
+// Validatable fall back to a single shared StructValidator, which is itself safe for concurrent use, so
+// there's no need for the instance-per-call pooling poolValidator does for user-supplied callbacks.
+type selfValidating struct {
+	fallback *StructValidator
+}
+
+// NewSelfValidating - Create a validator that, for each argument implementing Validatable, calls its
+// Validate method directly instead of going through a user-supplied ValidateCallback. Arguments that
+// don't implement Validatable fall back to the tag-driven StructValidator, so self-validating types and
+// struct-tag validation can be mixed freely on the same Validator.
+func NewSelfValidating() Validator {
+	return &selfValidating{fallback: NewStructValidator()}
+}
+
+// AddValidators - no-op: selfValidating has no pool of instances to seed
+func (s *selfValidating) AddValidators(...interface{}) {}
+
+// Validate - Validates the given arguments, delegating to Validatable.Validate or the fallback
+// StructValidator as appropriate
+func (s *selfValidating) Validate(args ...interface{}) (interface{}, error) {
+	return s.ValidateCtx(context.Background(), args...)
+}
+
+// ValidateCtx - Like Validate, but threads ctx through to a Validatable argument's Validate method
+func (s *selfValidating) ValidateCtx(ctx context.Context, args ...interface{}) (interface{}, error) {
+	return s.invoke(ctx, args)
+}
+
+// ValidateMultiple - Validate multiple data-sets, same semantics as poolValidator.ValidateMultiple
+func (s *selfValidating) ValidateMultiple(margs [][]interface{}) ([]interface{}, error) {
+	ret, err := s.ValidateMultipleCtx(context.Background(), margs)
+	if len(err) > 0 {
+		return ret, err
+	}
+	return ret, nil
+}
+
+// ValidateMultipleCtx - Like ValidateMultiple, but stops as soon as ctx is done, returning the results
+// gathered so far plus ctx.Err() appended to the ErrStack
+func (s *selfValidating) ValidateMultipleCtx(ctx context.Context, margs [][]interface{}) ([]interface{}, ErrStack) {
+	ret, err := s.multi(ctx, margs, false)
+	return ret, ErrStack(err)
+}
+
+// ValidateMultipleFullErrStack - Same as ValidateMultiple, only this time the full ErrStack is
+// returned, including nil errors, so callers can work out which data-set caused which error
+func (s *selfValidating) ValidateMultipleFullErrStack(margs [][]interface{}) ([]interface{}, ErrStack) {
+	ret, err := s.multi(context.Background(), margs, true)
+	return ret, ErrStack(err)
+}
+
+// multi - actual implementation of ValidateMultiple. ctx is checked between iterations so a cancelled
+// or expired context stops the batch early, returning the partial results gathered so far plus the
+// context error appended to the ErrStack.
+func (s *selfValidating) multi(ctx context.Context, margs [][]interface{}, allErrs bool) ([]interface{}, []*ValidationError) {
+	res := make([]interface{}, 0, len(margs))
+	errs := make([]*ValidationError, 0, len(margs))
+	for idx, args := range margs {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, toValidationError(idx, err))
+			break
+		}
+		i, err := s.invoke(ctx, args)
+		res = append(res, i)
+		if allErrs || err != nil {
+			errs = append(errs, toValidationError(idx, err))
+		}
+	}
+	return res, errs
+}
+
+// invoke - runs args through fallback, unless the first argument is a Validatable, in which case it
+// carries its own state and is validated directly
+func (s *selfValidating) invoke(ctx context.Context, args []interface{}) (interface{}, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	if sv, ok := args[0].(Validatable); ok {
+		return args[0], sv.Validate(ctx)
+	}
+	if errs := s.fallback.Validate(args[0]); len(errs) > 0 {
+		return args[0], errs
+	}
+	return args[0], nil
+}
+
+// ValidateConcurrent - Like ValidateMultiple, but fans the work out across workers goroutines. Unlike
+// poolValidator, there's no per-worker instance to pull from a pool: the fallback StructValidator is
+// shared and safe for concurrent use, so workers call invoke directly. Results and errors are written
+// back at their original index, so output ordering matches input ordering regardless of scheduling.
+func (s *selfValidating) ValidateConcurrent(margs [][]interface{}, workers int) ([]interface{}, ErrStack) {
+	n := len(margs)
+	res := make([]interface{}, n)
+	errs := make([]*ValidationError, n)
+	if n == 0 {
+		return res, nil
+	}
+	if workers <= 0 {
+		workers = 1
+	}
+	if workers > n {
+		workers = n
+	}
+	chunk := (n + workers - 1) / workers
+	var wg sync.WaitGroup
+	for start := 0; start < n; start += chunk {
+		end := start + chunk
+		if end > n {
+			end = n
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				var err error
+				res[i], err = s.invoke(context.Background(), margs[i])
+				errs[i] = toValidationError(i, err)
+			}
+		}(start, end)
+	}
+	wg.Wait()
+	return res, ErrStack(errs)
+}
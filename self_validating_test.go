@@ -0,0 +1,43 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+type user struct {
+	Password        string
+	PasswordConfirm string
+}
+
+func (u user) Validate(ctx context.Context) error {
+	if u.Password != u.PasswordConfirm {
+		return fmt.Errorf("password confirmation does not match")
+	}
+	return nil
+}
+
+func TestSelfValidatingValidatable(t *testing.T) {
+	v := NewSelfValidating()
+	if _, err := v.Validate(user{Password: "hunter2", PasswordConfirm: "hunter2"}); err != nil {
+		t.Fatalf("Unexpected error %+v validating matching passwords", err)
+	}
+	if _, err := v.Validate(user{Password: "hunter2", PasswordConfirm: "nope"}); err == nil {
+		t.Fatal("Expected an error for mismatched password confirmation")
+	}
+}
+
+type plainForm struct {
+	Name string `validate:"required"`
+}
+
+func TestSelfValidatingFallback(t *testing.T) {
+	v := NewSelfValidating()
+	if _, err := v.Validate(plainForm{Name: "Ada"}); err != nil {
+		t.Fatalf("Unexpected error %+v validating plain form", err)
+	}
+	if _, err := v.Validate(plainForm{}); err == nil {
+		t.Fatal("Expected an error for a missing required field via the struct-tag fallback")
+	}
+}
@@ -0,0 +1,285 @@
+package validator
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// parsedRule - a single parsed validation rule extracted from a `validate` struct tag, e.g. "min=1"
+// parses into parsedRule{name: "min", arg: "1"}
+type parsedRule struct {
+	name string
+	arg  string
+}
+
+// cachedField - reflection metadata for a single struct field, computed once per type and then reused
+type cachedField struct {
+	index int
+	name  string
+	rules []parsedRule
+	kind  reflect.Kind
+}
+
+// RuleFunc - validates a single field value against a rule argument, returning a non-nil error on failure
+type RuleFunc func(field string, value interface{}, kind reflect.Kind, arg string) error
+
+// ruleRunner - pooled, reusable buffer for collecting rule violations during a single Validate call
+type ruleRunner struct {
+	errs []*ValidationError
+}
+
+// StructValidator - validates arbitrary structs by reading `validate:"..."` struct tags. Field
+// descriptors are parsed once per reflect.Type and cached, so repeat calls for the same type skip all
+// tag parsing; only the rule-runner buffer comes from the pool, keeping the hot path allocation-light.
+type StructValidator struct {
+	pool  *sync.Pool
+	cache sync.Map // reflect.Type -> []cachedField
+	rules map[string]RuleFunc
+}
+
+// NewStructValidator - Create a new tag-driven struct validator with the built-in rule set registered
+func NewStructValidator() *StructValidator {
+	sv := &StructValidator{
+		pool: &sync.Pool{
+			New: func() interface{} { return &ruleRunner{} },
+		},
+		rules: map[string]RuleFunc{},
+	}
+	sv.registerBuiltins()
+	return sv
+}
+
+// AddRule - register (or override) a named rule that tags can reference
+func (sv *StructValidator) AddRule(name string, fn RuleFunc) {
+	sv.rules[name] = fn
+}
+
+// Validate - validates s, a struct or pointer to struct, against its `validate` tags
+func (sv *StructValidator) Validate(s interface{}) ErrStack {
+	val := reflect.ValueOf(s)
+	for val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return ErrStack{(&ValidationError{}).Message("value passed to Validate is not a struct")}
+	}
+	fields := sv.fieldsFor(val.Type())
+	runner := sv.pool.Get().(*ruleRunner)
+	runner.errs = runner.errs[:0]
+	defer sv.pool.Put(runner)
+	for _, f := range fields {
+		fv := val.Field(f.index)
+		for _, r := range f.rules {
+			fn, ok := sv.rules[r.name]
+			if !ok {
+				continue
+			}
+			if err := fn(f.name, fv.Interface(), f.kind, r.arg); err != nil {
+				runner.errs = append(runner.errs, (&ValidationError{
+					Field: f.name,
+					Tag:   r.name,
+					Value: fv.Interface(),
+				}).Message("%s: %s", f.name, err.Error()))
+			}
+		}
+	}
+	if len(runner.errs) == 0 {
+		return nil
+	}
+	out := make(ErrStack, len(runner.errs))
+	copy(out, runner.errs)
+	return out
+}
+
+// fieldsFor - returns the cached field descriptors for t, parsing and caching them on first sight
+func (sv *StructValidator) fieldsFor(t reflect.Type) []cachedField {
+	if cached, ok := sv.cache.Load(t); ok {
+		return cached.([]cachedField)
+	}
+	fields := make([]cachedField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			// unexported field - fv.Interface() would panic on it, and it can't be set by callers anyway
+			continue
+		}
+		tag := sf.Tag.Get("validate")
+		if tag == "" {
+			continue
+		}
+		fields = append(fields, cachedField{
+			index: i,
+			name:  sf.Name,
+			rules: parseTag(tag),
+			kind:  sf.Type.Kind(),
+		})
+	}
+	sv.cache.Store(t, fields)
+	return fields
+}
+
+// parseTag - splits a `validate:"..."` tag into its individual rules
+func parseTag(tag string) []parsedRule {
+	parts := strings.Split(tag, ",")
+	rules := make([]parsedRule, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		name, arg := p, ""
+		if idx := strings.IndexByte(p, '='); idx >= 0 {
+			name, arg = p[:idx], p[idx+1:]
+		}
+		rules = append(rules, parsedRule{name: name, arg: arg})
+	}
+	return rules
+}
+
+func (sv *StructValidator) registerBuiltins() {
+	sv.rules["required"] = ruleRequired
+	sv.rules["min"] = ruleMin
+	sv.rules["max"] = ruleMax
+	sv.rules["len"] = ruleLen
+	sv.rules["email"] = ruleEmail
+	sv.rules["oneof"] = ruleOneof
+	sv.rules["multipleof"] = ruleMultipleOf
+}
+
+func ruleRequired(field string, value interface{}, kind reflect.Kind, arg string) error {
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() || rv.IsZero() {
+		return fmt.Errorf("is required")
+	}
+	return nil
+}
+
+func ruleMin(field string, value interface{}, kind reflect.Kind, arg string) error {
+	threshold, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return fmt.Errorf("invalid min argument %q", arg)
+	}
+	f, ok := numericValue(value, kind)
+	if !ok {
+		return fmt.Errorf("min is not supported on kind %s", kind)
+	}
+	if f < threshold {
+		return fmt.Errorf("must be >= %v", threshold)
+	}
+	return nil
+}
+
+func ruleMax(field string, value interface{}, kind reflect.Kind, arg string) error {
+	threshold, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return fmt.Errorf("invalid max argument %q", arg)
+	}
+	f, ok := numericValue(value, kind)
+	if !ok {
+		return fmt.Errorf("max is not supported on kind %s", kind)
+	}
+	if f > threshold {
+		return fmt.Errorf("must be <= %v", threshold)
+	}
+	return nil
+}
+
+func ruleLen(field string, value interface{}, kind reflect.Kind, arg string) error {
+	n, err := strconv.Atoi(arg)
+	if err != nil {
+		return fmt.Errorf("invalid len argument %q", arg)
+	}
+	switch kind {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		if l := reflect.ValueOf(value).Len(); l != n {
+			return fmt.Errorf("must have length %d, got %d", n, l)
+		}
+	default:
+		return fmt.Errorf("len is not supported on kind %s", kind)
+	}
+	return nil
+}
+
+func ruleEmail(field string, value interface{}, kind reflect.Kind, arg string) error {
+	s, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("email is only supported on string fields")
+	}
+	at := strings.IndexByte(s, '@')
+	if at <= 0 || at == len(s)-1 || !strings.Contains(s[at+1:], ".") {
+		return fmt.Errorf("must be a valid email address")
+	}
+	return nil
+}
+
+func ruleOneof(field string, value interface{}, kind reflect.Kind, arg string) error {
+	opts := strings.Fields(arg)
+	s := fmt.Sprintf("%v", value)
+	for _, o := range opts {
+		if o == s {
+			return nil
+		}
+	}
+	return fmt.Errorf("must be one of %v", opts)
+}
+
+// ruleMultipleOf - validates that value is a multiple of the threshold encoded in arg, converting the
+// operand according to reflect.Kind so signed, unsigned and float fields are all handled natively
+func ruleMultipleOf(field string, value interface{}, kind reflect.Kind, arg string) error {
+	rv := reflect.ValueOf(value)
+	switch {
+	case kind >= reflect.Int && kind <= reflect.Int64:
+		threshold, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid multipleof argument %q", arg)
+		}
+		if threshold == 0 || rv.Int()%threshold != 0 {
+			return fmt.Errorf("must be a multiple of %d", threshold)
+		}
+	case kind >= reflect.Uint && kind <= reflect.Uintptr:
+		threshold, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid multipleof argument %q", arg)
+		}
+		if threshold == 0 || rv.Uint()%threshold != 0 {
+			return fmt.Errorf("must be a multiple of %d", threshold)
+		}
+	case kind == reflect.Float32 || kind == reflect.Float64:
+		threshold, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return fmt.Errorf("invalid multipleof argument %q", arg)
+		}
+		if threshold == 0 {
+			return fmt.Errorf("multipleof threshold cannot be 0")
+		}
+		const epsilon = 1e-9
+		rem := math.Abs(math.Mod(rv.Float(), threshold))
+		if rem > epsilon && math.Abs(threshold)-rem > epsilon {
+			return fmt.Errorf("must be a multiple of %v", threshold)
+		}
+	default:
+		return fmt.Errorf("multipleof is not supported on kind %s", kind)
+	}
+	return nil
+}
+
+// numericValue - converts value to a float64 for threshold comparisons, using len() for string/slice/
+// map/array kinds so rules like min/max double as length bounds on those types
+func numericValue(value interface{}, kind reflect.Kind) (float64, bool) {
+	rv := reflect.ValueOf(value)
+	switch {
+	case kind >= reflect.Int && kind <= reflect.Int64:
+		return float64(rv.Int()), true
+	case kind >= reflect.Uint && kind <= reflect.Uintptr:
+		return float64(rv.Uint()), true
+	case kind == reflect.Float32 || kind == reflect.Float64:
+		return rv.Float(), true
+	case kind == reflect.String, kind == reflect.Slice, kind == reflect.Map, kind == reflect.Array:
+		return float64(rv.Len()), true
+	}
+	return 0, false
+}
@@ -0,0 +1,75 @@
+package validator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type signupForm struct {
+	Name  string `validate:"required"`
+	Age   int    `validate:"min=18,max=130"`
+	Email string `validate:"required,email"`
+	Role  string `validate:"oneof=admin member guest"`
+	Score int    `validate:"multipleof=5"`
+}
+
+func TestStructValidatorValid(t *testing.T) {
+	sv := NewStructValidator()
+	form := signupForm{Name: "Ada", Age: 30, Email: "ada@example.com", Role: "member", Score: 10}
+	if errs := sv.Validate(form); len(errs) != 0 {
+		t.Fatalf("Unexpected errors validating valid form: %+v", errs)
+	}
+}
+
+func TestStructValidatorInvalid(t *testing.T) {
+	sv := NewStructValidator()
+	form := signupForm{Name: "", Age: 5, Email: "not-an-email", Role: "superuser", Score: 7}
+	errs := sv.Validate(form)
+	if len(errs) != 5 {
+		t.Fatalf("Expected 5 validation errors, got %d: %+v", len(errs), errs)
+	}
+}
+
+func TestStructValidatorErrorMessageNamesField(t *testing.T) {
+	sv := NewStructValidator()
+	errs := sv.Validate(signupForm{Name: "", Age: 5, Email: "ada@example.com", Role: "member", Score: 10})
+	for _, e := range errs {
+		if !strings.Contains(e.Error(), e.Field) {
+			t.Fatalf("Expected %q to mention the field name %q it failed on", e.Error(), e.Field)
+		}
+	}
+}
+
+func TestStructValidatorCachesFields(t *testing.T) {
+	sv := NewStructValidator()
+	form := signupForm{Name: "Ada", Age: 30, Email: "ada@example.com", Role: "member", Score: 10}
+	sv.Validate(form)
+	if _, ok := sv.cache.Load(reflect.TypeOf(form)); !ok {
+		t.Fatal("Expected field descriptors to be cached after first Validate call")
+	}
+}
+
+func TestStructValidatorSkipsUnexportedFields(t *testing.T) {
+	type withUnexported struct {
+		Name   string `validate:"required"`
+		secret string `validate:"required"`
+	}
+	sv := NewStructValidator()
+	if errs := sv.Validate(withUnexported{Name: "Ada"}); len(errs) != 0 {
+		t.Fatalf("Unexpected errors validating an unexported, tagged field: %+v", errs)
+	}
+}
+
+func TestRuleMultipleOfFloat(t *testing.T) {
+	type withFloat struct {
+		V float64 `validate:"multipleof=0.5"`
+	}
+	sv := NewStructValidator()
+	if errs := sv.Validate(withFloat{V: 1.5}); len(errs) != 0 {
+		t.Fatalf("Unexpected errors: %+v", errs)
+	}
+	if errs := sv.Validate(withFloat{V: 1.3}); len(errs) == 0 {
+		t.Fatal("Expected an error for 1.3 not being a multiple of 0.5")
+	}
+}
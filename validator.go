@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
@@ -10,25 +11,138 @@ import (
 // convenience is the main reason for this type
 type ValidateCallback func(v interface{}, args ...interface{}) (interface{}, error)
 
+// ValidateCallbackCtx - like ValidateCallback, but also receives the context the call was made with,
+// so long-running validations can observe cancellation and deadlines
+type ValidateCallbackCtx func(ctx context.Context, v interface{}, args ...interface{}) (interface{}, error)
+
+// adaptCallback - wraps a context-less ValidateCallback so it can be used wherever a ValidateCallbackCtx
+// is expected; ctx is ignored, which keeps New backward compatible
+func adaptCallback(cb ValidateCallback) ValidateCallbackCtx {
+	return func(ctx context.Context, v interface{}, args ...interface{}) (interface{}, error) {
+		return cb(v, args...)
+	}
+}
+
 // Validator - the exposed interface
 type Validator interface {
 	Validate(...interface{}) (interface{}, error)
+	ValidateCtx(ctx context.Context, args ...interface{}) (interface{}, error)
 	ValidateMultiple([][]interface{}) ([]interface{}, error)
+	ValidateMultipleCtx(ctx context.Context, margs [][]interface{}) ([]interface{}, ErrStack)
 	AddValidators(...interface{})
 	ValidateMultipleFullErrStack([][]interface{}) ([]interface{}, ErrStack)
+	ValidateConcurrent(margs [][]interface{}, workers int) ([]interface{}, ErrStack)
 }
 
 // ErrStack - type returned when validating multple data-sets
-type ErrStack []error
+type ErrStack []*ValidationError
+
+// ValidationError - a single, structured validation failure. Index correlates the error back to its
+// position in a batch, which ValidateMultipleFullErrStack and ValidateConcurrent populate for every
+// slot, including ones that didn't fail, so callers can line failures up with their input without
+// counting. Message is a fluent builder rather than a plain field, since a field and a method can't
+// share a name.
+type ValidationError struct {
+	Index int
+	Field string
+	Tag   string
+	Value interface{}
+	Cause error
+
+	message string
+}
+
+// Key - fluently sets the field name this error refers to
+func (e *ValidationError) Key(field string) *ValidationError {
+	e.Field = field
+	return e
+}
+
+// Message - fluently sets the human-readable message for this error, fmt.Sprintf-style
+func (e *ValidationError) Message(format string, args ...interface{}) *ValidationError {
+	e.message = fmt.Sprintf(format, args...)
+	return e
+}
+
+// Error - implement the built-in error interface
+func (e *ValidationError) Error() string {
+	switch {
+	case e.message != "":
+		return e.message
+	case e.Cause != nil:
+		return e.Cause.Error()
+	case e.Field != "":
+		return fmt.Sprintf("%s failed %s validation", e.Field, e.Tag)
+	default:
+		return fmt.Sprintf("failed %s validation", e.Tag)
+	}
+}
+
+// Unwrap - allows errors.Is/errors.As to reach the underlying cause, if any
+func (e *ValidationError) Unwrap() error {
+	return e.Cause
+}
+
+// isFailure - reports whether this entry represents an actual failure, as opposed to a placeholder
+// slot kept around purely so its Index lines up with the input (see ValidateMultipleFullErrStack)
+func (e *ValidationError) isFailure() bool {
+	return e != nil && (e.Cause != nil || e.message != "" || e.Field != "" || e.Tag != "")
+}
+
+// toValidationError - wraps err for slot idx. A *ValidationError returned by a callback is passed
+// through as-is (with its Index stamped), so user code can emit rich errors directly; anything else
+// is wrapped as the Cause of a new ValidationError.
+func toValidationError(idx int, err error) *ValidationError {
+	if err == nil {
+		return &ValidationError{Index: idx}
+	}
+	if ve, ok := err.(*ValidationError); ok {
+		ve.Index = idx
+		return ve
+	}
+	return &ValidationError{Index: idx, Cause: err}
+}
+
+// ByField - returns every error in the stack whose Field matches name
+func (es ErrStack) ByField(name string) []*ValidationError {
+	out := make([]*ValidationError, 0, len(es))
+	for _, e := range es {
+		if e != nil && e.Field == name {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// First - returns the first entry in the stack that represents an actual failure, or nil if there is none
+func (es ErrStack) First() *ValidationError {
+	for _, e := range es {
+		if e.isFailure() {
+			return e
+		}
+	}
+	return nil
+}
 
 // internal validator -> contains pool + callback to apply to all relevant objects
 type poolValidator struct {
 	pool   *sync.Pool
-	invoke ValidateCallback
+	invoke ValidateCallbackCtx
 }
 
 // New - Create new pooled validator
 func New(n func() interface{}, i ValidateCallback) Validator {
+	return &poolValidator{
+		pool: &sync.Pool{
+			New: n,
+		},
+		invoke: adaptCallback(i),
+	}
+}
+
+// NewCtx - Create new pooled validator from a context-aware callback, for validators whose invoke
+// logic needs to observe cancellation or deadlines (e.g. ones performing I/O)
+func NewCtx(n func() interface{}, i ValidateCallbackCtx) Validator {
 	return &poolValidator{
 		pool: &sync.Pool{
 			New: n,
@@ -46,50 +160,126 @@ func (pv *poolValidator) AddValidators(vs ...interface{}) {
 
 // Validate - Validates given arguments on pooled validator
 // the validator is automatically returned to the pool
-func (pv *poolValidator) Validate(args ...interface{}) (i interface{}, err error) {
+func (pv *poolValidator) Validate(args ...interface{}) (interface{}, error) {
+	return pv.ValidateCtx(context.Background(), args...)
+}
+
+// ValidateCtx - Like Validate, but threads ctx through to the underlying callback so validations that
+// perform I/O can observe cancellation or a deadline. Like multi, it routes through invokeArgs, so a
+// Validatable argument bypasses the callback here too; an instance is still taken from the pool for
+// the call.
+func (pv *poolValidator) ValidateCtx(ctx context.Context, args ...interface{}) (interface{}, error) {
 	v := pv.pool.Get()
-	i, err = pv.invoke(v, args...)
+	i, err := pv.invokeArgs(ctx, v, args)
 	pv.pool.Put(v)
-	return
+	return i, err
 }
 
 // ValidateMultiple - Validate multiple data-sets. This is roughly equivalent of calling Validate
 // in a loop, but it doens't get a new validator from the pool each time.
 func (pv *poolValidator) ValidateMultiple(margs [][]interface{}) ([]interface{}, error) {
-	ret, err := pv.multi(margs, false)
+	ret, err := pv.ValidateMultipleCtx(context.Background(), margs)
 	if len(err) > 0 {
-		return ret, ErrStack(err)
+		return ret, err
 	}
 	return ret, nil
 }
 
+// ValidateMultipleCtx - Like ValidateMultiple, but stops as soon as ctx is done, returning the results
+// gathered so far plus ctx.Err() appended to the ErrStack
+func (pv *poolValidator) ValidateMultipleCtx(ctx context.Context, margs [][]interface{}) ([]interface{}, ErrStack) {
+	ret, err := pv.multi(ctx, margs, false)
+	return ret, ErrStack(err)
+}
+
 // ValidateMultipleFullErrStack - Same as ValidateMultiple, only this time the full ErrStack is returned, including nil errors
 // this allows you to easily work out which data-set caused the error
 func (pv *poolValidator) ValidateMultipleFullErrStack(margs [][]interface{}) ([]interface{}, ErrStack) {
-	ret, err := pv.multi(margs, true)
+	ret, err := pv.multi(context.Background(), margs, true)
 	return ret, ErrStack(err)
 }
 
-// actual implementation of ValidateMultiple
-func (pv *poolValidator) multi(margs [][]interface{}, allErrs bool) ([]interface{}, []error) {
+// actual implementation of ValidateMultiple. ctx is checked between iterations so a cancelled or
+// expired context stops the batch early, returning the partial results gathered so far plus the
+// context error appended to the ErrStack.
+func (pv *poolValidator) multi(ctx context.Context, margs [][]interface{}, allErrs bool) ([]interface{}, []*ValidationError) {
 	v := pv.pool.Get()
 	res := make([]interface{}, 0, len(margs))
-	errs := make([]error, 0, len(margs))
-	for _, args := range margs {
-		i, err := pv.invoke(v, args...)
+	errs := make([]*ValidationError, 0, len(margs))
+	for idx, args := range margs {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, toValidationError(idx, err))
+			break
+		}
+		i, err := pv.invokeArgs(ctx, v, args)
 		res = append(res, i)
 		if allErrs || err != nil {
-			errs = append(errs, err)
+			errs = append(errs, toValidationError(idx, err))
 		}
 	}
 	pv.pool.Put(v)
 	return res, errs
 }
 
+// invokeArgs - runs args through the pooled callback, unless the first argument is a Validatable,
+// in which case it carries its own state and is validated directly without calling the callback. v
+// has already been taken from the pool by the caller (once per call, batch or worker) either way.
+func (pv *poolValidator) invokeArgs(ctx context.Context, v interface{}, args []interface{}) (interface{}, error) {
+	if len(args) > 0 {
+		if sv, ok := args[0].(Validatable); ok {
+			return args[0], sv.Validate(ctx)
+		}
+	}
+	return pv.invoke(ctx, v, args...)
+}
+
+// ValidateConcurrent - Like ValidateMultiple, but fans the work out across workers goroutines. Each
+// worker pulls its own validator instance from the pool and processes a contiguous slice of margs, so
+// pool contention is the only synchronization point. Results and errors are written back at their
+// original index, so output ordering matches input ordering regardless of scheduling.
+func (pv *poolValidator) ValidateConcurrent(margs [][]interface{}, workers int) ([]interface{}, ErrStack) {
+	n := len(margs)
+	res := make([]interface{}, n)
+	errs := make([]*ValidationError, n)
+	if n == 0 {
+		return res, nil
+	}
+	if workers <= 0 {
+		workers = 1
+	}
+	if workers > n {
+		workers = n
+	}
+	chunk := (n + workers - 1) / workers
+	var wg sync.WaitGroup
+	for start := 0; start < n; start += chunk {
+		end := start + chunk
+		if end > n {
+			end = n
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			v := pv.pool.Get()
+			defer pv.pool.Put(v)
+			for i := start; i < end; i++ {
+				var err error
+				res[i], err = pv.invokeArgs(context.Background(), v, margs[i])
+				errs[i] = toValidationError(i, err)
+			}
+		}(start, end)
+	}
+	wg.Wait()
+	return res, ErrStack(errs)
+}
+
 // Error - implement built-in error interface on ErrStack
 func (es ErrStack) Error() string {
 	str := make([]string, 0, len(es))
 	for _, e := range es {
+		if !e.isFailure() {
+			continue
+		}
 		str = append(str, fmt.Sprintf("%+v", e))
 	}
 	return strings.Join(str, "\n")
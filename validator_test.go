@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"context"
 	"fmt"
 	"testing"
 )
@@ -68,6 +69,14 @@ func TestValidMultiWithErrs(t *testing.T) {
 	if len(err) != len(multi) {
 		t.Fatalf("Unexpected number of error values: Expected %d, got %d", len(multi), len(err))
 	}
+	for k, ve := range err {
+		if ve.Index != k {
+			t.Fatalf("Expected entry %d to carry Index %d, got %d", k, k, ve.Index)
+		}
+	}
+	if err.First() != nil {
+		t.Fatalf("Expected no failures in a fully valid batch, got %+v", err.First())
+	}
 	t.Logf("%s\n", err.Error())
 }
 
@@ -92,6 +101,10 @@ func TestSetWithError(t *testing.T) {
 	if err == nil {
 		t.Fatal("Expected an error")
 	}
+	es := err.(ErrStack)
+	if first := es.First(); first == nil || first.Index != 3 {
+		t.Fatalf("Expected the first failure to be at index 3, got %+v", first)
+	}
 	for k, val := range r {
 		ri := val.(int)
 		if ri != data[k] {
@@ -100,6 +113,105 @@ func TestSetWithError(t *testing.T) {
 	}
 }
 
+func TestValidateConcurrent(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	multi := [][]interface{}{}
+	for _, i := range data {
+		multi = append(multi, []interface{}{i})
+	}
+	v := getSimpleVal()
+	r, err := v.ValidateConcurrent(multi, 4)
+	if len(err) != len(multi) {
+		t.Fatalf("Unexpected number of error values: Expected %d, got %d", len(multi), len(err))
+	}
+	for k, val := range r {
+		ri := val.(int)
+		if ri != data[k] {
+			t.Fatalf("Expected %d to equal %d at index %d, result ordering does not match input", data[k], ri, k)
+		}
+	}
+}
+
+// BenchmarkValidateParallel - measures ValidateConcurrent's throughput as the worker count grows. This
+// doesn't use b.RunParallel: that helper measures contention between multiple goroutines independently
+// calling the benchmarked function, but ValidateConcurrent already does its own internal fan-out across
+// workers goroutines per call, so running b.N copies of it in parallel would just be benchmarking worker
+// counts squared. Sweeping workers via b.Run and calling ValidateConcurrent sequentially is what actually
+// shows how throughput scales with the worker count.
+func BenchmarkValidateParallel(b *testing.B) {
+	v := getSimpleVal()
+	multi := make([][]interface{}, 1000)
+	for i := range multi {
+		multi[i] = []interface{}{(i % 10) + 1}
+	}
+	for _, workers := range []int{1, 2, 4, 8, 16} {
+		workers := workers
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, errs := v.ValidateConcurrent(multi, workers); errs.First() != nil {
+					b.Fatalf("unexpected error: %+v", errs.First())
+				}
+			}
+		})
+	}
+}
+
+func TestValidMultiWithValidatable(t *testing.T) {
+	v := getSimpleVal()
+	multi := [][]interface{}{
+		{user{Password: "a", PasswordConfirm: "a"}},
+		{user{Password: "a", PasswordConfirm: "b"}},
+	}
+	_, err := v.ValidateMultiple(multi)
+	if err == nil {
+		t.Fatal("Expected an error from the mismatched Validatable in the batch")
+	}
+	if len(ErrStack(err.(ErrStack))) != 1 {
+		t.Fatalf("Expected exactly 1 error, got %d", len(err.(ErrStack)))
+	}
+}
+
+func TestValidateSingleWithValidatable(t *testing.T) {
+	// v's callback only knows how to assert int args; a Validatable must never reach it, the same
+	// as in multi/ValidateConcurrent, or this panics on the type assertion inside the callback.
+	v := getSimpleVal()
+	if _, err := v.Validate(user{Password: "a", PasswordConfirm: "a"}); err != nil {
+		t.Fatalf("Unexpected error %+v validating matching passwords", err)
+	}
+	if _, err := v.Validate(user{Password: "a", PasswordConfirm: "b"}); err == nil {
+		t.Fatal("Expected an error for mismatched password confirmation")
+	}
+	if _, err := v.ValidateCtx(context.Background(), user{Password: "a", PasswordConfirm: "b"}); err == nil {
+		t.Fatal("Expected an error for mismatched password confirmation via ValidateCtx")
+	}
+}
+
+func TestValidateMultipleCtxCancelled(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	multi := [][]interface{}{}
+	for _, i := range data {
+		multi = append(multi, []interface{}{i})
+	}
+	v := getSimpleVal()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r, errs := v.ValidateMultipleCtx(ctx, multi)
+	if len(r) != 0 {
+		t.Fatalf("Expected no results for an already-cancelled context, got %d", len(r))
+	}
+	if len(errs) != 1 || errs[0].Cause != context.Canceled {
+		t.Fatalf("Expected a single context.Canceled error, got %+v", errs)
+	}
+}
+
+func TestValidateCtx(t *testing.T) {
+	v := getSimpleVal()
+	if _, err := v.ValidateCtx(context.Background(), 5); err != nil {
+		t.Fatalf("Unexpected error %+v", err)
+	}
+}
+
 func (sv simpleVal) inRange(i int) bool {
 	if i < sv.min || i > sv.max {
 		return false